@@ -0,0 +1,426 @@
+package valueextractor
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrInvalidBindTarget is added as an extract error (under the key "<bind>")
+// when Bind is called with something other than a pointer to a struct.
+var ErrInvalidBindTarget = errors.New("bind target must be a pointer to a struct")
+
+// ErrUnsupportedBindField is added as an extract error for field shapes Bind
+// doesn't know how to populate, such as a pointer to a slice.
+var ErrUnsupportedBindField = errors.New("unsupported bind field")
+
+// bindTagNames lists the struct tags Bind looks for, in priority order, to
+// determine the extraction key for a field. The first tag present on the
+// field wins.
+var bindTagNames = []string{"query", "form", "header", "path", "cookie"}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// bindField describes how a single struct field should be populated by Bind.
+// A per-type slice of these is built once via reflection and cached, so
+// repeated Bind calls for the same struct type don't re-reflect.
+type bindField struct {
+	index       int
+	key         string
+	isPointer   bool
+	isSlice     bool
+	isTime      bool
+	timeFormat  string
+	hasDefault  bool
+	defaultVal  string
+	min, max    *float64
+	unsupported bool
+
+	isNested   bool
+	nestedType reflect.Type
+	prefix     string
+}
+
+var bindPlanCache sync.Map // map[reflect.Type][]bindField
+
+// bindPlanFor returns the cached bindField plan for t, building it on first use.
+func bindPlanFor(t reflect.Type) []bindField {
+	if cached, ok := bindPlanCache.Load(t); ok {
+		return cached.([]bindField)
+	}
+
+	plan := buildBindPlan(t)
+	bindPlanCache.Store(t, plan)
+
+	return plan
+}
+
+func buildBindPlan(t reflect.Type) []bindField {
+	plan := make([]bindField, 0, t.NumField())
+
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		fieldType := sf.Type
+		isPointer := fieldType.Kind() == reflect.Ptr
+		if isPointer {
+			fieldType = fieldType.Elem()
+		}
+
+		if fieldType.Kind() == reflect.Struct && fieldType != timeType {
+			plan = append(plan, bindField{
+				index:      i,
+				isPointer:  isPointer,
+				isNested:   true,
+				nestedType: fieldType,
+				prefix:     sf.Tag.Get("prefix"),
+			})
+			continue
+		}
+
+		key, ok := bindKeyFor(sf)
+		if !ok {
+			continue
+		}
+
+		if isPointer && fieldType.Kind() == reflect.Slice {
+			plan = append(plan, bindField{index: i, key: key, unsupported: true})
+			continue
+		}
+
+		field := bindField{
+			index:      i,
+			key:        key,
+			isPointer:  isPointer,
+			isSlice:    fieldType.Kind() == reflect.Slice,
+			isTime:     fieldType == timeType,
+			timeFormat: sf.Tag.Get("format"),
+		}
+
+		if field.isTime && field.timeFormat == "" {
+			field.timeFormat = time.RFC3339
+		}
+
+		if def, ok := sf.Tag.Lookup("default"); ok {
+			field.hasDefault = true
+			field.defaultVal = def
+		}
+
+		if isNumericBindKind(fieldType) {
+			field.min, field.max = parseValidateTag(sf.Tag.Get("validate"))
+		}
+
+		plan = append(plan, field)
+	}
+
+	return plan
+}
+
+// bindKeyFor returns the extraction key for sf from the first recognized
+// tag present, and whether one was found at all.
+func bindKeyFor(sf reflect.StructField) (string, bool) {
+	for _, name := range bindTagNames {
+		if val, ok := sf.Tag.Lookup(name); ok {
+			return val, true
+		}
+	}
+
+	return "", false
+}
+
+// isNumericBindKind reports whether fieldType (or, for a slice, its element
+// type) is a kind that `validate:"min=...,max=..."` can meaningfully apply
+// to. min/max on any other kind (string, bool, time.Time, ...) is ignored,
+// rather than failing the bind with a confusing numeric parse error.
+func isNumericBindKind(fieldType reflect.Type) bool {
+	kind := fieldType.Kind()
+	if kind == reflect.Slice {
+		kind = fieldType.Elem().Kind()
+	}
+
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// parseValidateTag parses a tag like "min=1,max=100" into bounds. Malformed
+// entries are ignored rather than rejected, matching the library's policy of
+// never panicking on bad input.
+func parseValidateTag(tag string) (min, max *float64) {
+	if tag == "" {
+		return nil, nil
+	}
+
+	for _, part := range strings.Split(tag, ",") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+
+		val, err := strconv.ParseFloat(kv[1], 64)
+		if err != nil {
+			continue
+		}
+
+		switch kv[0] {
+		case "min":
+			min = &val
+		case "max":
+			max = &val
+		}
+	}
+
+	return min, max
+}
+
+// Bind populates dst, a pointer to a struct, by reading `query`/`form`/`header`/
+// `path`/`cookie` struct tags and running each field's value through the same
+// extraction and conversion flow as With, so failures are collected as usual
+// ordinary *Error values rather than aborting the bind early.
+//
+// Supported field shapes: primitives, pointers (treated as optional), slices
+// (populated from repeated values, e.g. url.Values[key]), time.Time (parsed
+// using an optional `format` tag, default time.RFC3339), and nested structs
+// (optionally prefixed via a `prefix` tag).
+func (ec *Extractor) Bind(dst interface{}) {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		ec.AddExtractError("<bind>", ErrInvalidBindTarget)
+		return
+	}
+
+	ec.bindStruct(v.Elem(), "")
+}
+
+func (ec *Extractor) bindStruct(v reflect.Value, prefix string) {
+	for _, f := range bindPlanFor(v.Type()) {
+		fv := v.Field(f.index)
+		fullKey := prefix + f.key
+
+		if f.isNested {
+			ec.bindNested(f, fv, prefix)
+			continue
+		}
+
+		if f.unsupported {
+			ec.AddExtractError(fullKey, fmt.Errorf("%w: %s (pointer-to-slice fields are not supported)", ErrUnsupportedBindField, fullKey))
+			continue
+		}
+
+		if f.isSlice {
+			ec.bindSlice(f, fv, fullKey)
+			continue
+		}
+
+		if f.isPointer {
+			ec.bindPointer(f, fv, fullKey)
+			continue
+		}
+
+		converter := withRangeValidation(f, scalarConverter(f, fv))
+		ec.bindWith(fullKey, f.hasDefault, f.defaultVal, false, converter)
+	}
+}
+
+func (ec *Extractor) bindNested(f bindField, fv reflect.Value, prefix string) {
+	if f.isPointer {
+		if fv.IsNil() {
+			fv.Set(reflect.New(f.nestedType))
+		}
+		fv = fv.Elem()
+	}
+
+	ec.bindStruct(fv, prefix+f.prefix)
+}
+
+func (ec *Extractor) bindPointer(f bindField, fv reflect.Value, key string) {
+	elem := reflect.New(fv.Type().Elem()).Elem()
+	converter := withRangeValidation(f, scalarConverter(f, elem))
+
+	if ec.bindWith(key, f.hasDefault, f.defaultVal, true, converter) {
+		fv.Set(elem.Addr())
+	}
+}
+
+func (ec *Extractor) bindSlice(f bindField, fv reflect.Value, key string) {
+	vals, ok := rawSliceValues(ec.extractor, key)
+	if !ok || len(vals) == 0 {
+		if f.hasDefault {
+			vals = strings.Split(f.defaultVal, ",")
+		} else {
+			return
+		}
+	}
+
+	slice := reflect.MakeSlice(fv.Type(), len(vals), len(vals))
+
+	var convErr error
+	for i, raw := range vals {
+		converter := withRangeValidation(f, scalarConverter(f, slice.Index(i)))
+		if err := converter(ec, raw); err != nil {
+			convErr = errors.Join(convErr, fmt.Errorf("index %d: %w", i, err))
+		}
+	}
+
+	if convErr != nil {
+		ec.AddConvertError(key, convErr)
+	}
+
+	fv.Set(slice)
+}
+
+// rawSliceValues returns every value associated with key, for extractors that
+// implement MultiValueExtractor, or false if the underlying extractor doesn't
+// support repeated values or its GetAll returns any error (including
+// ErrNotFound for a missing key).
+func rawSliceValues(ex ValueExtractor, key string) ([]string, bool) {
+	mve, ok := ex.(MultiValueExtractor)
+	if !ok {
+		return nil, false
+	}
+
+	values, err := mve.GetAll(key)
+	if err != nil {
+		return nil, false
+	}
+
+	return values, true
+}
+
+// bindWith mirrors Extractor.With, but additionally supports a default value
+// for missing keys and an optional flag independent of WithOptionalKeys (used
+// for pointer fields, which are implicitly optional). It reports whether a
+// value was ultimately extracted and converted.
+func (ec *Extractor) bindWith(key string, hasDefault bool, defaultVal string, optional bool, converter Converter) bool {
+	str, err := ec.extractor.Get(key)
+	if err != nil {
+		if !errors.Is(err, ErrNotFound) {
+			ec.AddExtractError(key, err)
+			return false
+		}
+
+		switch {
+		case hasDefault:
+			str = defaultVal
+		case optional:
+			return false
+		default:
+			ec.AddExtractError(key, err)
+			return false
+		}
+	}
+
+	if err := converter(ec, str); err != nil {
+		ec.AddConvertError(key, err)
+		return false
+	}
+
+	return true
+}
+
+// scalarConverter builds a Converter that parses a raw string into fv
+// according to its kind, or into a time.Time using the field's format tag.
+func scalarConverter(f bindField, fv reflect.Value) Converter {
+	if f.isTime {
+		return func(ec *Extractor, value string) error {
+			parsed, err := time.Parse(f.timeFormat, value)
+			if err != nil {
+				return fmt.Errorf("invalid time value: %w", err)
+			}
+			fv.Set(reflect.ValueOf(parsed))
+			return nil
+		}
+	}
+
+	switch fv.Kind() {
+	case reflect.String:
+		return func(ec *Extractor, value string) error {
+			fv.SetString(value)
+			return nil
+		}
+	case reflect.Bool:
+		return func(ec *Extractor, value string) error {
+			parsed, err := strconv.ParseBool(value)
+			if err != nil {
+				return fmt.Errorf("invalid bool value: %w", err)
+			}
+			fv.SetBool(parsed)
+			return nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		bits := fv.Type().Bits()
+		return func(ec *Extractor, value string) error {
+			parsed, err := strconv.ParseInt(value, 10, bits)
+			if err != nil {
+				return fmt.Errorf("invalid int value: %w", err)
+			}
+			fv.SetInt(parsed)
+			return nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		bits := fv.Type().Bits()
+		return func(ec *Extractor, value string) error {
+			parsed, err := strconv.ParseUint(value, 10, bits)
+			if err != nil {
+				return fmt.Errorf("invalid uint value: %w", err)
+			}
+			fv.SetUint(parsed)
+			return nil
+		}
+	case reflect.Float32, reflect.Float64:
+		bits := fv.Type().Bits()
+		return func(ec *Extractor, value string) error {
+			parsed, err := strconv.ParseFloat(value, bits)
+			if err != nil {
+				return fmt.Errorf("invalid float value: %w", err)
+			}
+			fv.SetFloat(parsed)
+			return nil
+		}
+	default:
+		return func(ec *Extractor, value string) error {
+			return fmt.Errorf("unsupported bind field kind: %s", fv.Kind())
+		}
+	}
+}
+
+// withRangeValidation wraps converter with the field's min/max bounds, parsed
+// from its `validate` tag, checked against the raw numeric value.
+func withRangeValidation(f bindField, converter Converter) Converter {
+	if f.min == nil && f.max == nil {
+		return converter
+	}
+
+	return func(ec *Extractor, value string) error {
+		if err := converter(ec, value); err != nil {
+			return err
+		}
+
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("invalid numeric value for validation: %w", err)
+		}
+
+		if f.min != nil && parsed < *f.min {
+			return fmt.Errorf("value %v is below minimum %v", parsed, *f.min)
+		}
+
+		if f.max != nil && parsed > *f.max {
+			return fmt.Errorf("value %v is above maximum %v", parsed, *f.max)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,139 @@
+package valueextractor
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+type bindAddress struct {
+	City string `query:"city"`
+}
+
+type bindTarget struct {
+	Name    string      `query:"name"`
+	Age     uint64      `query:"age" validate:"min=1,max=130"`
+	Nick    *string     `query:"nick"`
+	Tags    []string    `query:"tag"`
+	Joined  time.Time   `query:"joined" format:"2006-01-02"`
+	Limit   int64       `query:"limit" default:"10"`
+	Address bindAddress `prefix:"addr_"`
+}
+
+func TestBindBasic(t *testing.T) {
+	req, _ := http.NewRequest("GET", "http://localhost:8080?name=John&age=30&tag=a&tag=b&joined=2024-01-02&addr_city=Austin", nil)
+
+	var dst bindTarget
+	ex := Using(QueryExtractor{Query: req.URL.Query()})
+	ex.Bind(&dst)
+
+	switch {
+	case ex.Errors() != nil:
+		t.Fatal(ex.Errors())
+	case dst.Name != "John":
+		t.Fatalf("Name not bound correctly: %q", dst.Name)
+	case dst.Age != 30:
+		t.Fatalf("Age not bound correctly: %d", dst.Age)
+	case dst.Nick != nil:
+		t.Fatal("Nick should remain nil when absent")
+	case len(dst.Tags) != 2 || dst.Tags[0] != "a" || dst.Tags[1] != "b":
+		t.Fatalf("Tags not bound correctly: %v", dst.Tags)
+	case !dst.Joined.Equal(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)):
+		t.Fatalf("Joined not bound correctly: %v", dst.Joined)
+	case dst.Limit != 10:
+		t.Fatalf("Limit default not applied: %d", dst.Limit)
+	case dst.Address.City != "Austin":
+		t.Fatalf("Nested Address not bound correctly: %q", dst.Address.City)
+	}
+}
+
+func TestBindValidationError(t *testing.T) {
+	req, _ := http.NewRequest("GET", "http://localhost:8080?name=John&age=999", nil)
+
+	var dst bindTarget
+	ex := Using(QueryExtractor{Query: req.URL.Query()})
+	ex.Bind(&dst)
+
+	errs := ex.Errors()
+	if errs == nil {
+		t.Fatal("expected a validation error for age over max")
+	}
+}
+
+type bindPtrSliceTarget struct {
+	Tags *[]string `query:"tag"`
+}
+
+func TestBindPointerToSliceIsRejectedWithoutPanic(t *testing.T) {
+	req, _ := http.NewRequest("GET", "http://localhost:8080?tag=a&tag=b", nil)
+
+	var dst bindPtrSliceTarget
+	ex := Using(QueryExtractor{Query: req.URL.Query()})
+	ex.Bind(&dst)
+
+	if ex.Errors() == nil {
+		t.Fatal("expected an error for a pointer-to-slice field")
+	}
+}
+
+type bindSliceValidationTarget struct {
+	Nums []int64 `query:"n" validate:"min=0,max=10"`
+}
+
+func TestBindSliceElementValidation(t *testing.T) {
+	req, _ := http.NewRequest("GET", "http://localhost:8080?n=1&n=999&n=5", nil)
+
+	var dst bindSliceValidationTarget
+	ex := Using(QueryExtractor{Query: req.URL.Query()})
+	ex.Bind(&dst)
+
+	if ex.Errors() == nil {
+		t.Fatal("expected a validation error for a slice element over max")
+	}
+}
+
+type bindStringValidateTarget struct {
+	Name string `query:"name" validate:"min=1,max=100"`
+}
+
+func TestBindValidateIgnoredOnNonNumericField(t *testing.T) {
+	req, _ := http.NewRequest("GET", "http://localhost:8080?name=hello", nil)
+
+	var dst bindStringValidateTarget
+	ex := Using(QueryExtractor{Query: req.URL.Query()})
+	ex.Bind(&dst)
+
+	switch {
+	case ex.Errors() != nil:
+		t.Fatal(ex.Errors())
+	case dst.Name != "hello":
+		t.Fatalf("Name not bound correctly: %q", dst.Name)
+	}
+}
+
+func TestBindInvalidTarget(t *testing.T) {
+	ex := Using(QueryExtractor{Query: url.Values{}})
+
+	var notAPointer bindTarget
+	ex.Bind(notAPointer)
+
+	errs := ex.Errors()
+	if errs == nil {
+		t.Fatal("expected an error when binding into a non-pointer")
+	}
+}
+
+func BenchmarkBind(b *testing.B) {
+	req, _ := http.NewRequest("GET", "http://localhost:8080?name=John&age=30&tag=a&tag=b&joined=2024-01-02&addr_city=Austin", nil)
+
+	for i := 0; i < b.N; i++ {
+		var dst bindTarget
+		ex := Using(QueryExtractor{Query: req.URL.Query()})
+		ex.Bind(&dst)
+
+		if err := ex.Errors(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package valueextractor
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// ErrRequestParseBody is an error that is returned when a request body fails to decode
+var ErrRequestParseBody = errors.New("error parsing request body")
+
+// JSONExtractor is a value extractor that extracts values from a JSON request body.
+// Get accepts a gjson-style dotted path (e.g. "user.address.city", "tags.0") and
+// walks the decoded body to find it. The body is decoded once, lazily, and cached.
+type JSONExtractor struct {
+	Request *http.Request
+
+	parsed   bool
+	data     interface{}
+	parseErr error
+}
+
+// ensureParsed decodes the request body on first use and caches the result
+func (je *JSONExtractor) ensureParsed() error {
+	if je.parsed {
+		return je.parseErr
+	}
+	je.parsed = true
+
+	if je.Request == nil || je.Request.Body == nil {
+		je.parseErr = ErrRequestNil
+		return je.parseErr
+	}
+
+	defer je.Request.Body.Close()
+
+	if err := json.NewDecoder(je.Request.Body).Decode(&je.data); err != nil {
+		je.parseErr = errors.Join(ErrRequestParseBody, err)
+	}
+
+	return je.parseErr
+}
+
+// Get walks the decoded JSON body along a dotted path and returns the value found there
+func (je *JSONExtractor) Get(key string) (string, error) {
+	if err := je.ensureParsed(); err != nil {
+		return "", err
+	}
+
+	value, ok := lookupPath(je.data, key)
+	if !ok {
+		return "", ErrNotFound
+	}
+
+	return stringifyPathValue(value)
+}
+
+// XMLExtractor is a value extractor that extracts values from an XML request body.
+// Get accepts the same dotted path syntax as JSONExtractor (e.g. "user.address.city",
+// "items.0.name"). The body is decoded once, lazily, into a generic element tree and cached.
+type XMLExtractor struct {
+	Request *http.Request
+
+	parsed   bool
+	data     interface{}
+	parseErr error
+}
+
+// ensureParsed decodes the request body on first use and caches the result
+func (xe *XMLExtractor) ensureParsed() error {
+	if xe.parsed {
+		return xe.parseErr
+	}
+	xe.parsed = true
+
+	if xe.Request == nil || xe.Request.Body == nil {
+		xe.parseErr = ErrRequestNil
+		return xe.parseErr
+	}
+
+	defer xe.Request.Body.Close()
+
+	data, err := decodeXML(xe.Request.Body)
+	if err != nil {
+		xe.parseErr = errors.Join(ErrRequestParseBody, err)
+		return xe.parseErr
+	}
+
+	xe.data = data
+
+	return nil
+}
+
+// Get walks the decoded XML body along a dotted path and returns the value found there
+func (xe *XMLExtractor) Get(key string) (string, error) {
+	if err := xe.ensureParsed(); err != nil {
+		return "", err
+	}
+
+	value, ok := lookupPath(xe.data, key)
+	if !ok {
+		return "", ErrNotFound
+	}
+
+	return stringifyPathValue(value)
+}
+
+// decodeXML reads the root element of r into a tree of map[string]interface{} (for
+// elements with children), []interface{} (for repeated sibling elements), and string
+// (for leaf text), so it can be walked by the same dotted path logic as JSON.
+func decodeXML(r io.Reader) (interface{}, error) {
+	dec := xml.NewDecoder(r)
+
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		if start, ok := tok.(xml.StartElement); ok {
+			return decodeXMLElement(dec, start)
+		}
+	}
+}
+
+func decodeXMLElement(dec *xml.Decoder, start xml.StartElement) (interface{}, error) {
+	children := map[string][]interface{}{}
+	var text strings.Builder
+
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			child, err := decodeXMLElement(dec, t)
+			if err != nil {
+				return nil, err
+			}
+			children[t.Name.Local] = append(children[t.Name.Local], child)
+		case xml.CharData:
+			text.Write(t)
+		case xml.EndElement:
+			if t.Name.Local == start.Name.Local {
+				if len(children) == 0 {
+					return strings.TrimSpace(text.String()), nil
+				}
+
+				result := make(map[string]interface{}, len(children))
+				for name, vals := range children {
+					if len(vals) == 1 {
+						result[name] = vals[0]
+					} else {
+						result[name] = vals
+					}
+				}
+
+				return result, nil
+			}
+		}
+	}
+}
+
+// lookupPath walks data along a dotted path, indexing into maps by key and into
+// slices by numeric index (e.g. "items.0.name"), returning false if any segment
+// along the way isn't present.
+func lookupPath(data interface{}, path string) (interface{}, bool) {
+	current := data
+
+	for _, segment := range strings.Split(path, ".") {
+		switch node := current.(type) {
+		case map[string]interface{}:
+			value, ok := node[segment]
+			if !ok {
+				return nil, false
+			}
+			current = value
+		case []interface{}:
+			idx, err := strconv.Atoi(segment)
+			if err != nil || idx < 0 || idx >= len(node) {
+				return nil, false
+			}
+			current = node[idx]
+		default:
+			return nil, false
+		}
+	}
+
+	return current, true
+}
+
+// stringifyPathValue renders a decoded JSON/XML value as the string our Converters
+// expect: scalars render plainly (no quotes), and objects/arrays render as JSON text
+// so they can still be captured whole via AsJSONRaw.
+func stringifyPathValue(v interface{}) (string, error) {
+	switch val := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return val, nil
+	case bool:
+		return strconv.FormatBool(val), nil
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64), nil
+	default:
+		b, err := json.Marshal(val)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	}
+}
@@ -0,0 +1,89 @@
+package valueextractor
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestJSONExtractorDottedPath(t *testing.T) {
+	body := `{"user":{"name":"John","address":{"city":"Austin"}},"tags":["a","b"],"age":30}`
+	req, _ := http.NewRequest("POST", "http://localhost:8080", strings.NewReader(body))
+
+	ex := Using(&JSONExtractor{Request: req})
+
+	var name, city, tag string
+	var age int64
+	ex.With("user.name", AsString(&name))
+	ex.With("user.address.city", AsString(&city))
+	ex.With("tags.1", AsString(&tag))
+	ex.With("age", AsInt64(&age))
+
+	switch {
+	case ex.Errors() != nil:
+		t.Fatal(ex.Errors())
+	case name != "John":
+		t.Fatalf("name not extracted correctly: %q", name)
+	case city != "Austin":
+		t.Fatalf("city not extracted correctly: %q", city)
+	case tag != "b":
+		t.Fatalf("tag not extracted correctly: %q", tag)
+	case age != 30:
+		t.Fatalf("age not extracted correctly: %d", age)
+	}
+}
+
+func TestJSONExtractorMissingPath(t *testing.T) {
+	req, _ := http.NewRequest("POST", "http://localhost:8080", strings.NewReader(`{"user":{}}`))
+	ex := Using(&JSONExtractor{Request: req}, WithOptionalKeys("user.address.city"))
+
+	var city string
+	ex.With("user.address.city", AsString(&city))
+
+	if err := ex.Errors(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestJSONExtractorAsJSONRaw(t *testing.T) {
+	req, _ := http.NewRequest("POST", "http://localhost:8080", strings.NewReader(`{"address":{"city":"Austin"}}`))
+	ex := Using(&JSONExtractor{Request: req})
+
+	var raw json.RawMessage
+	ex.With("address", AsJSONRaw(&raw))
+
+	if err := ex.Errors(); err != nil {
+		t.Fatal(err)
+	}
+
+	var addr struct {
+		City string `json:"city"`
+	}
+	if err := json.Unmarshal(raw, &addr); err != nil {
+		t.Fatal(err)
+	}
+	if addr.City != "Austin" {
+		t.Fatalf("city not extracted correctly from raw json: %q", addr.City)
+	}
+}
+
+func TestXMLExtractorDottedPath(t *testing.T) {
+	body := `<user><name>John</name><tags><tag>a</tag><tag>b</tag></tags></user>`
+	req, _ := http.NewRequest("POST", "http://localhost:8080", strings.NewReader(body))
+
+	ex := Using(&XMLExtractor{Request: req})
+
+	var name, tag string
+	ex.With("name", AsString(&name))
+	ex.With("tags.tag.1", AsString(&tag))
+
+	switch {
+	case ex.Errors() != nil:
+		t.Fatal(ex.Errors())
+	case name != "John":
+		t.Fatalf("name not extracted correctly: %q", name)
+	case tag != "b":
+		t.Fatalf("tag not extracted correctly: %q", tag)
+	}
+}
@@ -0,0 +1,146 @@
+package valueextractor
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Chain composes several Converters into a single pipeline, running each in
+// order against the value for a key, e.g.
+//
+//	ex.With("email", Chain(Trim(), Lower(), Matches(emailRe), AsString(&e)))
+//
+// Transformer stages (Trim, Lower, ...) rewrite the value seen by later
+// stages; validator stages (NonEmpty, Min, Max, OneOf, Matches, Length,
+// AfterParseInt, ...) just reject it. The first stage to return an error
+// stops the chain, and that error is what ends up in the Extractor's error
+// chain via AddConvertError - other keys on the same Extractor are
+// unaffected.
+func Chain(converters ...Converter) Converter {
+	return func(ec *Extractor, value string) error {
+		current := value
+
+		for _, converter := range converters {
+			ec.chainScratch = nil
+
+			if err := converter(ec, current); err != nil {
+				return err
+			}
+
+			if ec.chainScratch != nil {
+				current = *ec.chainScratch
+				ec.chainScratch = nil
+			}
+		}
+
+		return nil
+	}
+}
+
+// transform is used internally by transformer stages (Trim, Lower) to rewrite
+// the value a Chain passes to its later stages.
+func (ec *Extractor) transform(value string) {
+	ec.chainScratch = &value
+}
+
+// Trim trims leading and trailing whitespace. Meant for use inside a Chain.
+func Trim() Converter {
+	return func(ec *Extractor, value string) error {
+		ec.transform(strings.TrimSpace(value))
+		return nil
+	}
+}
+
+// Lower lowercases the value. Meant for use inside a Chain.
+func Lower() Converter {
+	return func(ec *Extractor, value string) error {
+		ec.transform(strings.ToLower(value))
+		return nil
+	}
+}
+
+// NonEmpty rejects an empty value.
+func NonEmpty() Converter {
+	return func(ec *Extractor, value string) error {
+		if value == "" {
+			return errors.New("value must not be empty")
+		}
+		return nil
+	}
+}
+
+// Min rejects a value that parses as a number below n.
+func Min(n float64) Converter {
+	return func(ec *Extractor, value string) error {
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("invalid numeric value: %w", err)
+		}
+		if parsed < n {
+			return fmt.Errorf("value %v is below minimum %v", parsed, n)
+		}
+		return nil
+	}
+}
+
+// Max rejects a value that parses as a number above n.
+func Max(n float64) Converter {
+	return func(ec *Extractor, value string) error {
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("invalid numeric value: %w", err)
+		}
+		if parsed > n {
+			return fmt.Errorf("value %v is above maximum %v", parsed, n)
+		}
+		return nil
+	}
+}
+
+// OneOf rejects a value that isn't one of options.
+func OneOf(options ...string) Converter {
+	return func(ec *Extractor, value string) error {
+		for _, option := range options {
+			if option == value {
+				return nil
+			}
+		}
+		return fmt.Errorf("value %q is not one of %v", value, options)
+	}
+}
+
+// Matches rejects a value that doesn't match re.
+func Matches(re *regexp.Regexp) Converter {
+	return func(ec *Extractor, value string) error {
+		if !re.MatchString(value) {
+			return fmt.Errorf("value %q does not match pattern %s", value, re.String())
+		}
+		return nil
+	}
+}
+
+// Length rejects a value whose length falls outside [min, max].
+func Length(min, max int) Converter {
+	return func(ec *Extractor, value string) error {
+		if len(value) < min || len(value) > max {
+			return fmt.Errorf("value length %d is out of range [%d, %d]", len(value), min, max)
+		}
+		return nil
+	}
+}
+
+// AfterParseInt parses value as an int64 and, on success, runs fn against the
+// parsed value - for validation that depends on the numeric value rather than
+// the raw string, e.g. Chain(AfterParseInt(func(v int64) error { ... }), AsInt64(&x)).
+func AfterParseInt(fn func(int64) error) Converter {
+	return func(ec *Extractor, value string) error {
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid int value: %w", err)
+		}
+		return fn(parsed)
+	}
+}
@@ -0,0 +1,73 @@
+package valueextractor
+
+import (
+	"errors"
+	"net/http"
+	"regexp"
+	"testing"
+)
+
+var testEmailRe = regexp.MustCompile(`^[^@]+@[^@]+\.[^@]+$`)
+
+func TestChainTransformAndValidate(t *testing.T) {
+	req, _ := http.NewRequest("GET", "http://localhost:8080?email=%20John%40Example.com%20", nil)
+	ex := Using(QueryExtractor{Query: req.URL.Query()})
+
+	var email string
+	ex.With("email", Chain(Trim(), Lower(), Matches(testEmailRe), AsString(&email)))
+
+	switch {
+	case ex.Errors() != nil:
+		t.Fatal(ex.Errors())
+	case email != "john@example.com":
+		t.Fatalf("email not transformed correctly: %q", email)
+	}
+}
+
+func TestChainValidationFailureStopsChain(t *testing.T) {
+	req, _ := http.NewRequest("GET", "http://localhost:8080?email=not-an-email", nil)
+	ex := Using(QueryExtractor{Query: req.URL.Query()})
+
+	var email string
+	ex.With("email", Chain(Trim(), Lower(), Matches(testEmailRe), AsString(&email)))
+
+	errs := ex.Errors()
+	switch {
+	case errs == nil:
+		t.Fatal("expected a validation error")
+	case email != "":
+		t.Fatalf("email should not have been set on validation failure: %q", email)
+	}
+}
+
+func TestChainAfterParseInt(t *testing.T) {
+	req, _ := http.NewRequest("GET", "http://localhost:8080?age=200", nil)
+	ex := Using(QueryExtractor{Query: req.URL.Query()})
+
+	var age int64
+	ex.With("age", Chain(AfterParseInt(func(v int64) error {
+		if v > 130 {
+			return errors.New("age exceeds maximum")
+		}
+		return nil
+	}), AsInt64(&age)))
+
+	if ex.Errors() == nil {
+		t.Fatal("expected age validation error")
+	}
+}
+
+func TestNonEmptyAndOneOf(t *testing.T) {
+	req, _ := http.NewRequest("GET", "http://localhost:8080?role=admin&note=", nil)
+	ex := Using(QueryExtractor{Query: req.URL.Query()}, WithOptionalKeys("note"))
+
+	var role string
+	ex.With("role", Chain(NonEmpty(), OneOf("admin", "user"), AsString(&role)))
+
+	switch {
+	case ex.Errors() != nil:
+		t.Fatal(ex.Errors())
+	case role != "admin":
+		t.Fatalf("role not extracted correctly: %q", role)
+	}
+}
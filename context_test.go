@@ -0,0 +1,102 @@
+package valueextractor
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestWithContextRespectsCancellation(t *testing.T) {
+	req, _ := http.NewRequest("GET", "http://localhost:8080?name=John", nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	ex := UsingContext(ctx, QueryExtractor{Query: req.URL.Query()})
+
+	var name string
+	ex.WithContext("name", AsContextConverter(AsString(&name)))
+
+	if ex.Errors() == nil {
+		t.Fatal("expected an error for an already-cancelled context")
+	}
+}
+
+func TestWithContextPassesDeadline(t *testing.T) {
+	req, _ := http.NewRequest("GET", "http://localhost:8080?name=John", nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	ex := UsingContext(ctx, QueryExtractor{Query: req.URL.Query()})
+
+	var name string
+	var seenDeadline bool
+	ex.WithContext("name", func(ctx context.Context, ec *Extractor, value string) error {
+		if _, ok := ctx.Deadline(); ok {
+			seenDeadline = true
+		}
+		name = value
+		return nil
+	})
+
+	switch {
+	case ex.Errors() != nil:
+		t.Fatal(ex.Errors())
+	case !seenDeadline:
+		t.Fatal("expected the converter to see the extractor's deadline")
+	case name != "John":
+		t.Fatalf("name not extracted correctly: %q", name)
+	}
+}
+
+func TestWithContextRunsKeyMiddleware(t *testing.T) {
+	req, _ := http.NewRequest("GET", "http://localhost:8080?name=John", nil)
+
+	var seenKeys []string
+	middleware := func(key string, next Converter) Converter {
+		return func(ec *Extractor, value string) error {
+			seenKeys = append(seenKeys, key)
+			return next(ec, value)
+		}
+	}
+
+	ex := UsingContext(context.Background(), QueryExtractor{Query: req.URL.Query()}, WithKeyMiddleware(middleware))
+
+	var name string
+	ex.WithContext("name", AsContextConverter(AsString(&name)))
+
+	switch {
+	case ex.Errors() != nil:
+		t.Fatal(ex.Errors())
+	case len(seenKeys) != 1 || seenKeys[0] != "name":
+		t.Fatalf("middleware did not observe the key read via WithContext: %v", seenKeys)
+	}
+}
+
+func TestWithKeyMiddleware(t *testing.T) {
+	req, _ := http.NewRequest("GET", "http://localhost:8080?name=John&age=30", nil)
+
+	var seenKeys []string
+	middleware := func(key string, next Converter) Converter {
+		return func(ec *Extractor, value string) error {
+			seenKeys = append(seenKeys, key)
+			return next(ec, value)
+		}
+	}
+
+	ex := Using(QueryExtractor{Query: req.URL.Query()}, WithKeyMiddleware(middleware))
+
+	var name string
+	var age uint64
+	ex.With("name", AsString(&name))
+	ex.With("age", AsUint64(&age))
+
+	switch {
+	case ex.Errors() != nil:
+		t.Fatal(ex.Errors())
+	case len(seenKeys) != 2 || seenKeys[0] != "name" || seenKeys[1] != "age":
+		t.Fatalf("middleware did not observe expected keys: %v", seenKeys)
+	}
+}
@@ -1,13 +1,19 @@
 package valueextractor
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 )
 
 // Converter is a function that takes an Extractor and a key and returns a value and an error
 type Converter func(ec *Extractor, value string) error
 
+// SliceConverter is a function that takes an Extractor and every value found for a
+// repeated key, and converts them. It's the WithAll analog of Converter.
+type SliceConverter func(ec *Extractor, values []string) error
+
 // DirectReturnType is a function that takes an Extractor and a key and returns a value
 // this is a more performant alternative to the Result generic.
 type DirectReturnType func(ec *Extractor, key string) interface{}
@@ -105,3 +111,77 @@ func ReturnBool(ec *Extractor, key string) *bool {
 	ec.With("age", AsBool(&i))
 	return &i
 }
+
+// AsJSONRaw stores the raw JSON text of a value as-is, for opting into partial
+// structured extraction (e.g. pulling a nested object or array out of a
+// JSONExtractor without fully decoding it into a Go type up front)
+func AsJSONRaw(ref *json.RawMessage) Converter {
+	return func(ec *Extractor, value string) error {
+		*ref = json.RawMessage(value)
+		return nil
+	}
+}
+
+// AsCSV splits a single value on sep and stores the resulting parts
+func AsCSV(sep string, ref *[]string) Converter {
+	return func(ec *Extractor, value string) error {
+		*ref = strings.Split(value, sep)
+		return nil
+	}
+}
+
+// AsStringSlice stores every value found for a repeated key as-is
+func AsStringSlice(ref *[]string) SliceConverter {
+	return func(ec *Extractor, values []string) error {
+		*ref = values
+		return nil
+	}
+}
+
+// AsInt64Slice converts every value found for a repeated key to an int64
+func AsInt64Slice(ref *[]int64) SliceConverter {
+	return func(ec *Extractor, values []string) error {
+		parsed := make([]int64, len(values))
+		for i, value := range values {
+			v, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid int value at index %d: %v", i, err)
+			}
+			parsed[i] = v
+		}
+		*ref = parsed
+		return nil
+	}
+}
+
+// AsUint64Slice converts every value found for a repeated key to a uint64
+func AsUint64Slice(ref *[]uint64) SliceConverter {
+	return func(ec *Extractor, values []string) error {
+		parsed := make([]uint64, len(values))
+		for i, value := range values {
+			v, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid uint value at index %d: %v", i, err)
+			}
+			parsed[i] = v
+		}
+		*ref = parsed
+		return nil
+	}
+}
+
+// AsFloat64Slice converts every value found for a repeated key to a float64
+func AsFloat64Slice(ref *[]float64) SliceConverter {
+	return func(ec *Extractor, values []string) error {
+		parsed := make([]float64, len(values))
+		for i, value := range values {
+			v, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return fmt.Errorf("invalid float value at index %d: %v", i, err)
+			}
+			parsed[i] = v
+		}
+		*ref = parsed
+		return nil
+	}
+}
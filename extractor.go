@@ -1,6 +1,7 @@
 package valueextractor
 
 import (
+	"context"
 	"errors"
 	"fmt"
 )
@@ -8,9 +9,14 @@ import (
 // Extractor is a value extractor that can be used to extract values from a request
 // and type-convert them to the desired type, collecting errors along the way
 type Extractor struct {
-	extractor    ValueExtractor
-	errors       []*Error
-	optionalKeys []string
+	extractor          ValueExtractor
+	errors             []*Error
+	optionalKeys       []string
+	sources            map[string]ValueExtractor
+	sourceOptionalKeys map[string][]string
+	chainScratch       *string
+	ctx                context.Context
+	keyMiddleware      []func(key string, next Converter) Converter
 }
 
 func WithOptionalKeys(keys ...string) func(*Extractor) {
@@ -19,6 +25,36 @@ func WithOptionalKeys(keys ...string) func(*Extractor) {
 	}
 }
 
+// WithSources registers named ValueExtractors that WithSource can target, so a
+// single Extractor can pull different keys from different sources in one pass:
+//
+//	ex := Using(QueryExtractor{Query: r.URL.Query()}, WithSources(map[string]ValueExtractor{
+//	    "path":   PathExtractor{Path: r},
+//	    "header": HeaderExtractor{Header: r.Header},
+//	    "cookie": CookieExtractor{Request: r},
+//	}))
+func WithSources(sources map[string]ValueExtractor) func(*Extractor) {
+	return func(ex *Extractor) {
+		if ex.sources == nil {
+			ex.sources = make(map[string]ValueExtractor, len(sources))
+		}
+		for name, ve := range sources {
+			ex.sources[name] = ve
+		}
+	}
+}
+
+// WithOptionalKeysFor scopes optional keys to a single named source, so the
+// same key name can be required from one source and optional from another.
+func WithOptionalKeysFor(source string, keys ...string) func(*Extractor) {
+	return func(ex *Extractor) {
+		if ex.sourceOptionalKeys == nil {
+			ex.sourceOptionalKeys = make(map[string][]string)
+		}
+		ex.sourceOptionalKeys[source] = keys
+	}
+}
+
 func isOptional(ex *Extractor, key string) bool {
 	for _, k := range ex.optionalKeys {
 		if k == key {
@@ -29,6 +65,16 @@ func isOptional(ex *Extractor, key string) bool {
 	return false
 }
 
+func isOptionalFor(ex *Extractor, source, key string) bool {
+	for _, k := range ex.sourceOptionalKeys[source] {
+		if k == key {
+			return true
+		}
+	}
+
+	return false
+}
+
 // With taks a key and a converter and extracts the value from the request
 func (ec *Extractor) With(key string, converter Converter) {
 	str, err := ec.extractor.Get(key)
@@ -41,7 +87,85 @@ func (ec *Extractor) With(key string, converter Converter) {
 		return
 	}
 
-	if err := converter(ec, str); err != nil {
+	if err := ec.wrapMiddleware(key, converter)(ec, str); err != nil {
+		ec.AddConvertError(key, err)
+	}
+}
+
+// WithKeyMiddleware registers a middleware that wraps the Converter passed to
+// With and WithSource for every key, so cross-cutting concerns - logging,
+// metrics, auditing which fields were read - can be layered uniformly instead
+// of repeated at every call site. Middlewares registered first run outermost.
+func WithKeyMiddleware(middleware func(key string, next Converter) Converter) func(*Extractor) {
+	return func(ex *Extractor) {
+		ex.keyMiddleware = append(ex.keyMiddleware, middleware)
+	}
+}
+
+// wrapMiddleware wraps converter with every registered key middleware, in
+// registration order.
+func (ec *Extractor) wrapMiddleware(key string, converter Converter) Converter {
+	for i := len(ec.keyMiddleware) - 1; i >= 0; i-- {
+		converter = ec.keyMiddleware[i](key, converter)
+	}
+
+	return converter
+}
+
+// ErrUnknownSource is added as an extract error by WithSource when asked for
+// a source that wasn't registered via WithSources.
+var ErrUnknownSource = errors.New("unknown source")
+
+// WithSource behaves like With, but extracts key from the named source
+// instead of the Extractor's primary ValueExtractor. The source must have
+// been registered via the WithSources option.
+func (ec *Extractor) WithSource(source, key string, converter Converter) {
+	ve, ok := ec.sources[source]
+	if !ok {
+		ec.AddExtractError(key, fmt.Errorf("%w: %q", ErrUnknownSource, source))
+		return
+	}
+
+	str, err := ve.Get(key)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) && isOptionalFor(ec, source, key) {
+			return
+		}
+
+		ec.AddExtractError(key, err)
+		return
+	}
+
+	if err := ec.wrapMiddleware(key, converter)(ec, str); err != nil {
+		ec.AddConvertError(key, err)
+	}
+}
+
+// ErrUnsupportedOperation is added as an extract error by WithAll when the
+// Extractor's underlying ValueExtractor doesn't implement MultiValueExtractor.
+var ErrUnsupportedOperation = errors.New("extractor does not support repeated values")
+
+// WithAll behaves like With, but extracts every value associated with key
+// (e.g. repeated query parameters) via MultiValueExtractor, for use with
+// slice converters such as AsStringSlice.
+func (ec *Extractor) WithAll(key string, converter SliceConverter) {
+	mve, ok := ec.extractor.(MultiValueExtractor)
+	if !ok {
+		ec.AddExtractError(key, fmt.Errorf("%w: %T", ErrUnsupportedOperation, ec.extractor))
+		return
+	}
+
+	values, err := mve.GetAll(key)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) && isOptional(ec, key) {
+			return
+		}
+
+		ec.AddExtractError(key, err)
+		return
+	}
+
+	if err := converter(ec, values); err != nil {
 		ec.AddConvertError(key, err)
 	}
 }
@@ -59,7 +183,7 @@ func (ec *Extractor) AddConvertError(key string, err error) {
 // Using creates a new Extractor with the given value extractor
 // A value extractor is a function that takes a key and returns a value and an error, if any
 func Using(extractor ValueExtractor, options ...func(*Extractor)) *Extractor {
-	ex := &Extractor{extractor: extractor}
+	ex := &Extractor{extractor: extractor, ctx: context.Background()}
 	for _, option := range options {
 		option(ex)
 	}
@@ -67,6 +191,60 @@ func Using(extractor ValueExtractor, options ...func(*Extractor)) *Extractor {
 	return ex
 }
 
+// UsingContext creates a new Extractor the same way Using does, but threads ctx
+// through so ContextConverters passed to WithContext can respect deadlines and
+// cancellation (e.g. converters that do I/O, like a database lookup of a user ID).
+func UsingContext(ctx context.Context, extractor ValueExtractor, options ...func(*Extractor)) *Extractor {
+	ex := Using(extractor, options...)
+	ex.ctx = ctx
+
+	return ex
+}
+
+// ContextConverter is the context-aware counterpart to Converter, for
+// conversions that need to respect the Extractor's context, e.g. to perform a
+// cancellable database lookup.
+type ContextConverter func(ctx context.Context, ec *Extractor, value string) error
+
+// AsContextConverter adapts a plain Converter to a ContextConverter that
+// ignores ctx, so existing converters keep working unchanged under
+// WithContext.
+func AsContextConverter(converter Converter) ContextConverter {
+	return func(ctx context.Context, ec *Extractor, value string) error {
+		return converter(ec, value)
+	}
+}
+
+// WithContext behaves like With, but runs a ContextConverter against the
+// Extractor's context (context.Background() unless set via UsingContext),
+// fails the key immediately if that context is already done, and - like With -
+// passes the conversion through any middleware registered via
+// WithKeyMiddleware.
+func (ec *Extractor) WithContext(key string, converter ContextConverter) {
+	str, err := ec.extractor.Get(key)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) && isOptional(ec, key) {
+			return
+		}
+
+		ec.AddExtractError(key, err)
+		return
+	}
+
+	if err := ec.ctx.Err(); err != nil {
+		ec.AddConvertError(key, err)
+		return
+	}
+
+	wrapped := ec.wrapMiddleware(key, func(ec *Extractor, value string) error {
+		return converter(ec.ctx, ec, value)
+	})
+
+	if err := wrapped(ec, str); err != nil {
+		ec.AddConvertError(key, err)
+	}
+}
+
 // Errors returns an error if there are any errors in the parser
 func (ec *Extractor) Errors() []*Error {
 	if len(ec.errors) == 0 {
@@ -113,3 +291,15 @@ func ResultPtr[T any](ex *Extractor, key string, converter ResultConverter[T]) *
 	ex.With(key, converter(&result))
 	return &result
 }
+
+// ResultSliceConverter defines a wrapped slice converter with input argument as a reference
+// that returns a SliceConverter. It's intended to be used with the ResultSlice function
+type ResultSliceConverter[T any] func(*[]T) SliceConverter
+
+// ResultSlice is a function that extracts every value for key from the request and converts
+// them to the desired type. It offers a simpler API than the WithAll function
+func ResultSlice[T any](ex *Extractor, key string, converter ResultSliceConverter[T]) []T {
+	var result []T
+	ex.WithAll(key, converter(&result))
+	return result
+}
@@ -29,6 +29,13 @@ func (m MapExtractor) Get(key string) (string, error) {
 	return value, nil
 }
 
+// MultiValueExtractor is implemented by extractors that can return every value
+// associated with a repeated key (e.g. `?q=foo&q=bar`), where the plain Get
+// method would only ever surface the first one.
+type MultiValueExtractor interface {
+	GetAll(key string) ([]string, error)
+}
+
 // QueryExtractor is a value extractor that extracts values from a http request's query parameters
 type QueryExtractor struct {
 	Query url.Values
@@ -43,6 +50,15 @@ func (qe QueryExtractor) Get(key string) (string, error) {
 	return value, nil
 }
 
+// GetAll returns every value of a repeated query parameter from the request
+func (qe QueryExtractor) GetAll(key string) ([]string, error) {
+	values, ok := qe.Query[key]
+	if !ok || len(values) == 0 {
+		return nil, ErrNotFound
+	}
+	return values, nil
+}
+
 // ErrRequestNil is an error that is returned when the request is nil
 var ErrRequestNil = errors.New("request is nil")
 var ErrRequestParseForm = errors.New("error parsing form")
@@ -100,3 +116,107 @@ func (fe *FormExtractor) Get(key string) (string, error) {
 
 	return value, nil
 }
+
+// GetAll returns every value of a repeated form field from the Request
+func (fe *FormExtractor) GetAll(key string) ([]string, error) {
+	if fe.Request == nil {
+		return nil, ErrRequestNil
+	}
+
+	if err := fe.ensureParsed(); err != nil {
+		return nil, err
+	}
+
+	values := fe.Request.Form[key]
+	if len(values) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return values, nil
+}
+
+// HeaderExtractor is a value extractor that extracts values from a http request's headers
+type HeaderExtractor struct {
+	Header http.Header
+}
+
+// Get returns the value of a header from the request
+func (he HeaderExtractor) Get(key string) (string, error) {
+	value := he.Header.Get(key)
+	if value == "" {
+		return "", ErrNotFound
+	}
+	return value, nil
+}
+
+// CookieExtractor is a value extractor that extracts values from a http request's cookies
+type CookieExtractor struct {
+	Request *http.Request
+}
+
+// Get returns the value of a cookie from the request
+func (ce CookieExtractor) Get(key string) (string, error) {
+	if ce.Request == nil {
+		return "", ErrRequestNil
+	}
+
+	cookie, err := ce.Request.Cookie(key)
+	if err != nil {
+		return "", ErrNotFound
+	}
+
+	return cookie.Value, nil
+}
+
+// PathValuer is implemented by anything that can return a named path parameter's
+// string value. *http.Request satisfies it directly via PathValue (stdlib 1.22+);
+// other routers can be adapted with PathValuerFunc, e.g.
+// PathValuerFunc(func(key string) string { return chi.URLParam(r, key) }).
+type PathValuer interface {
+	PathValue(key string) string
+}
+
+// PathValuerFunc adapts a plain function to the PathValuer interface.
+type PathValuerFunc func(key string) string
+
+// PathValue calls f(key).
+func (f PathValuerFunc) PathValue(key string) string {
+	return f(key)
+}
+
+// PathExtractor is a value extractor that extracts values from a request's path
+// parameters via any router satisfying PathValuer.
+type PathExtractor struct {
+	Path PathValuer
+}
+
+// Get returns the value of a path parameter from the request
+func (pe PathExtractor) Get(key string) (string, error) {
+	value := pe.Path.PathValue(key)
+	if value == "" {
+		return "", ErrNotFound
+	}
+	return value, nil
+}
+
+// MultiExtractor composes several ValueExtractors, trying each in order and
+// returning the first value found. Useful when a key might legitimately come
+// from more than one place, e.g. checking the query string before falling
+// back to a form value.
+type MultiExtractor []ValueExtractor
+
+// Get returns the first value found for key across the composed extractors,
+// in order, or ErrNotFound if none of them have it.
+func (me MultiExtractor) Get(key string) (string, error) {
+	for _, ve := range me {
+		value, err := ve.Get(key)
+		if err == nil {
+			return value, nil
+		}
+		if !errors.Is(err, ErrNotFound) {
+			return "", err
+		}
+	}
+
+	return "", ErrNotFound
+}
@@ -0,0 +1,84 @@
+package valueextractor
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestWithSource(t *testing.T) {
+	req, _ := http.NewRequest("GET", "http://localhost:8080?page=2", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	req.AddCookie(&http.Cookie{Name: "session", Value: "abc123"})
+
+	ex := Using(QueryExtractor{Query: req.URL.Query()}, WithSources(map[string]ValueExtractor{
+		"path":   PathExtractor{Path: PathValuerFunc(func(key string) string { return map[string]string{"id": "42"}[key] })},
+		"header": HeaderExtractor{Header: req.Header},
+		"cookie": CookieExtractor{Request: req},
+	}))
+
+	var id int64
+	var page int64
+	var auth, session string
+
+	ex.With("page", AsInt64(&page))
+	ex.WithSource("path", "id", AsInt64(&id))
+	ex.WithSource("header", "Authorization", AsString(&auth))
+	ex.WithSource("cookie", "session", AsString(&session))
+
+	switch {
+	case ex.Errors() != nil:
+		t.Fatal(ex.Errors())
+	case id != 42:
+		t.Fatalf("id not extracted correctly: %d", id)
+	case page != 2:
+		t.Fatalf("page not extracted correctly: %d", page)
+	case auth != "Bearer token":
+		t.Fatalf("auth not extracted correctly: %q", auth)
+	case session != "abc123":
+		t.Fatalf("session not extracted correctly: %q", session)
+	}
+}
+
+func TestWithSourceUnknown(t *testing.T) {
+	ex := Using(QueryExtractor{Query: nil})
+
+	var s string
+	ex.WithSource("bogus", "key", AsString(&s))
+
+	errs := ex.Errors()
+	if errs == nil {
+		t.Fatal("expected an error for an unregistered source")
+	}
+}
+
+func TestWithSourceOptionalKeysFor(t *testing.T) {
+	ex := Using(QueryExtractor{Query: nil}, WithSources(map[string]ValueExtractor{
+		"header": HeaderExtractor{Header: http.Header{}},
+	}), WithOptionalKeysFor("header", "X-Foo"))
+
+	var s string
+	ex.WithSource("header", "X-Foo", AsString(&s))
+
+	if err := ex.Errors(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMultiExtractor(t *testing.T) {
+	me := MultiExtractor{
+		MapExtractor{},
+		MapExtractor{"name": "fallback"},
+	}
+
+	ex := Using(me)
+
+	var name string
+	ex.With("name", AsString(&name))
+
+	switch {
+	case ex.Errors() != nil:
+		t.Fatal(ex.Errors())
+	case name != "fallback":
+		t.Fatalf("name not extracted via fallback: %q", name)
+	}
+}
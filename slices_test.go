@@ -0,0 +1,61 @@
+package valueextractor
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestWithAllStringSlice(t *testing.T) {
+	req, _ := http.NewRequest("GET", "http://localhost:8080?tag=a&tag=b&tag=c", nil)
+	ex := Using(QueryExtractor{Query: req.URL.Query()})
+
+	var tags []string
+	ex.WithAll("tag", AsStringSlice(&tags))
+
+	switch {
+	case ex.Errors() != nil:
+		t.Fatal(ex.Errors())
+	case len(tags) != 3 || tags[0] != "a" || tags[1] != "b" || tags[2] != "c":
+		t.Fatalf("tags not extracted correctly: %v", tags)
+	}
+}
+
+func TestWithAllInt64Slice(t *testing.T) {
+	req, _ := http.NewRequest("GET", "http://localhost:8080?id=1&id=2&id=3", nil)
+	ex := Using(QueryExtractor{Query: req.URL.Query()})
+
+	ids := ResultSlice(ex, "id", AsInt64Slice)
+
+	switch {
+	case ex.Errors() != nil:
+		t.Fatal(ex.Errors())
+	case len(ids) != 3 || ids[0] != 1 || ids[1] != 2 || ids[2] != 3:
+		t.Fatalf("ids not extracted correctly: %v", ids)
+	}
+}
+
+func TestAsCSV(t *testing.T) {
+	req, _ := http.NewRequest("GET", "http://localhost:8080?tags=a,b,c", nil)
+	ex := Using(QueryExtractor{Query: req.URL.Query()})
+
+	var tags []string
+	ex.With("tags", AsCSV(",", &tags))
+
+	switch {
+	case ex.Errors() != nil:
+		t.Fatal(ex.Errors())
+	case len(tags) != 3 || tags[0] != "a" || tags[1] != "b" || tags[2] != "c":
+		t.Fatalf("tags not extracted correctly: %v", tags)
+	}
+}
+
+func TestWithAllUnsupportedExtractor(t *testing.T) {
+	ex := Using(MapExtractor{"tag": "a"})
+
+	var tags []string
+	ex.WithAll("tag", AsStringSlice(&tags))
+
+	if err := ex.Errors(); err == nil {
+		t.Fatal("expected an error for an extractor without repeated-value support")
+	}
+}